@@ -0,0 +1,199 @@
+package restful
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CompressorProvider describes a component that can hand out pooled, reusable
+// compress/gzip and compress/flate writers so that Route execution does not
+// allocate a new one per request.
+type CompressorProvider interface {
+	AcquireGzipWriter() *gzip.Writer
+	ReleaseGzipWriter(w *gzip.Writer)
+	AcquireFlateWriter() *flate.Writer
+	ReleaseFlateWriter(w *flate.Writer)
+}
+
+// CurrentCompressorProvider is the CompressorProvider used by CompressingResponseWriter.
+// Can be overridden to plug in a different pooling strategy.
+var CurrentCompressorProvider CompressorProvider = NewSyncPoolCompressors()
+
+// syncPoolCompessors is the default CompressorProvider, backed by sync.Pool.
+type syncPoolCompessors struct {
+	gzipWriterPool  sync.Pool
+	flateWriterPool sync.Pool
+}
+
+// NewSyncPoolCompressors creates a CompressorProvider backed by sync.Pool.
+func NewSyncPoolCompressors() *syncPoolCompessors {
+	return &syncPoolCompessors{
+		gzipWriterPool: sync.Pool{
+			New: func() interface{} { return newGzipWriter() },
+		},
+		flateWriterPool: sync.Pool{
+			New: func() interface{} { return newFlateWriter() },
+		},
+	}
+}
+
+func newGzipWriter() *gzip.Writer {
+	return gzip.NewWriter(io.Discard)
+}
+
+func newFlateWriter() *flate.Writer {
+	w, _ := flate.NewWriter(io.Discard, flate.BestSpeed)
+	return w
+}
+
+func (self *syncPoolCompessors) AcquireGzipWriter() *gzip.Writer {
+	return self.gzipWriterPool.Get().(*gzip.Writer)
+}
+
+func (self *syncPoolCompessors) ReleaseGzipWriter(w *gzip.Writer) {
+	w.Reset(io.Discard)
+	self.gzipWriterPool.Put(w)
+}
+
+func (self *syncPoolCompessors) AcquireFlateWriter() *flate.Writer {
+	return self.flateWriterPool.Get().(*flate.Writer)
+}
+
+func (self *syncPoolCompessors) ReleaseFlateWriter(w *flate.Writer) {
+	w.Reset(io.Discard)
+	self.flateWriterPool.Put(w)
+}
+
+// uncompressableContentTypes are skipped when negotiating Content-Encoding because
+// they are already compressed or would not benefit from it.
+var uncompressableContentTypes = map[string]bool{
+	"image/png":        true,
+	"image/jpeg":       true,
+	"image/gif":        true,
+	"application/zip":  true,
+	"application/gzip": true,
+}
+
+// CompressingResponseWriter wraps a http.ResponseWriter and transparently gzip/deflate
+// encodes the body when the negotiated encoding calls for it. The decision of whether to
+// actually compress is deferred until the route function sets its Content-Type and starts
+// writing the body, so that uncompressableContentTypes (set by the handler, not known
+// beforehand) are honored.
+type CompressingResponseWriter struct {
+	writer     http.ResponseWriter
+	compressor io.WriteCloser
+	encoding   string
+	decided    bool
+}
+
+// newCompressingResponseWriter creates a CompressingResponseWriter for the given encoding ("gzip" or "deflate").
+func newCompressingResponseWriter(writer http.ResponseWriter, encoding string) (*CompressingResponseWriter, error) {
+	return &CompressingResponseWriter{writer: writer, encoding: encoding}, nil
+}
+
+// decide chooses, on first use, whether to actually wrap the body in a compressor: skipped
+// for uncompressableContentTypes (the handler's Content-Type is only known at this point).
+func (self *CompressingResponseWriter) decide() {
+	if self.decided {
+		return
+	}
+	self.decided = true
+	contentType := self.writer.Header().Get("Content-Type")
+	if uncompressableContentTypes[strings.SplitN(contentType, ";", 2)[0]] {
+		return
+	}
+	if self.encoding == "gzip" {
+		w := CurrentCompressorProvider.AcquireGzipWriter()
+		w.Reset(self.writer)
+		self.compressor = w
+	} else if self.encoding == "deflate" {
+		w := CurrentCompressorProvider.AcquireFlateWriter()
+		w.Reset(self.writer)
+		self.compressor = w
+	}
+	self.writer.Header().Set("Content-Encoding", self.encoding)
+	self.writer.Header().Add("Vary", "Accept-Encoding")
+}
+
+// Header is part of http.ResponseWriter
+func (self *CompressingResponseWriter) Header() http.Header {
+	return self.writer.Header()
+}
+
+// WriteHeader is part of http.ResponseWriter
+func (self *CompressingResponseWriter) WriteHeader(status int) {
+	self.decide()
+	self.writer.WriteHeader(status)
+}
+
+// Write is part of http.ResponseWriter. It writes through the gzip/flate compressor,
+// unless the negotiated Content-Type turned out to be uncompressable.
+func (self *CompressingResponseWriter) Write(bytes []byte) (int, error) {
+	self.decide()
+	if self.compressor == nil {
+		return self.writer.Write(bytes)
+	}
+	return self.compressor.Write(bytes)
+}
+
+// CloseNotify is part of http.CloseNotifier
+func (self *CompressingResponseWriter) CloseNotify() <-chan bool {
+	return self.writer.(http.CloseNotifier).CloseNotify()
+}
+
+// Hijack is part of http.Hijacker, needed for e.g. websocket upgrades to pass through.
+func (self *CompressingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return self.writer.(http.Hijacker).Hijack()
+}
+
+// Close releases the underlying compressor back to the CompressorProvider.
+func (self *CompressingResponseWriter) Close() error {
+	if self.compressor == nil {
+		return nil
+	}
+	err := self.compressor.Close()
+	switch w := self.compressor.(type) {
+	case *gzip.Writer:
+		CurrentCompressorProvider.ReleaseGzipWriter(w)
+	case *flate.Writer:
+		CurrentCompressorProvider.ReleaseFlateWriter(w)
+	}
+	return err
+}
+
+// wantsCompressedResponse parses the Accept-Encoding header (using q-values) and
+// returns the encoding this repo knows how to produce ("gzip","deflate") or "" for none.
+// Whether the response body is actually compressible is decided later, once its
+// Content-Type is known, by CompressingResponseWriter.decide.
+func wantsCompressedResponse(acceptEncoding string) string {
+	best := ""
+	bestQ := 0.0
+	for _, each := range strings.Split(acceptEncoding, ",") {
+		parts := strings.Split(strings.TrimSpace(each), ";")
+		encoding := strings.TrimSpace(parts[0])
+		if encoding != "gzip" && encoding != "deflate" {
+			continue
+		}
+		q := 1.0
+		for _, param := range parts[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if parsed, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		if q > 0 && q > bestQ {
+			bestQ = q
+			best = encoding
+		}
+	}
+	return best
+}
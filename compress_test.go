@@ -0,0 +1,57 @@
+package restful
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWantsCompressedResponsePrefersHighestQValue(t *testing.T) {
+	cases := []struct {
+		acceptEncoding string
+		expected       string
+	}{
+		{"gzip", "gzip"},
+		{"deflate", "deflate"},
+		{"gzip;q=0.5, deflate;q=0.8", "deflate"},
+		{"gzip;q=0, deflate;q=0.1", "deflate"},
+		{"identity", ""},
+		{"", ""},
+	}
+	for _, c := range cases {
+		if got := wantsCompressedResponse(c.acceptEncoding); got != c.expected {
+			t.Errorf("wantsCompressedResponse(%q) = %q, want %q", c.acceptEncoding, got, c.expected)
+		}
+	}
+}
+
+func TestCompressingResponseWriterSkipsUncompressableContentType(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	writer, err := newCompressingResponseWriter(recorder, "gzip")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	writer.Header().Set("Content-Type", "image/png")
+	writer.Write([]byte("not actually a png"))
+
+	if recorder.Header().Get("Content-Encoding") != "" {
+		t.Errorf("expected no Content-Encoding header for an uncompressable Content-Type, got %q", recorder.Header().Get("Content-Encoding"))
+	}
+	if recorder.Body.String() != "not actually a png" {
+		t.Errorf("expected the body to be written through uncompressed, got %q", recorder.Body.String())
+	}
+}
+
+func TestCompressingResponseWriterCompressesPlainText(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	writer, err := newCompressingResponseWriter(recorder, "gzip")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	writer.Header().Set("Content-Type", "text/plain")
+	writer.Write([]byte("hello"))
+	writer.Close()
+
+	if recorder.Header().Get("Content-Encoding") != "gzip" {
+		t.Errorf("expected Content-Encoding: gzip, got %q", recorder.Header().Get("Content-Encoding"))
+	}
+}
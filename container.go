@@ -0,0 +1,151 @@
+package restful
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// RouteSelector is the interface implemented by the dispatchers that can select which Route to call for a given
+// incoming http Request. RouterJSR311 and CurlyRouter are two RouteSelector implementations.
+type RouteSelector interface {
+	SelectRoute(
+		webServices []*WebService,
+		httpRequest *http.Request) (selectedService *WebService, selected *Route, err error)
+}
+
+// Container holds a collection of WebServices and a http.ServeMux to dispatch http requests.
+// The optional Router is used to select the Service and Route for a specific http Request.
+type Container struct {
+	webServicesLock sync.RWMutex
+	webServices     []*WebService
+	ServeMux        *http.ServeMux
+	isRegisteredOnRoot      bool
+	containerFilters        []FilterFunction
+	router                  RouteSelector // default is a RouterJSR311
+	contentEncodingEnabled  bool          // default response Content-Encoding negotiation for WebServices that don't set their own
+}
+
+// NewContainer creates a new Container using a new ServeMux and the default RouterJSR311 router.
+func NewContainer() *Container {
+	return &Container{
+		webServices:        []*WebService{},
+		ServeMux:           http.NewServeMux(),
+		containerFilters:   []FilterFunction{},
+		router:             RouterJSR311{},
+	}
+}
+
+// DefaultContainer is a Container that uses http.DefaultServeMux
+var DefaultContainer = func() *Container {
+	container := NewContainer()
+	container.ServeMux = http.DefaultServeMux
+	return container
+}()
+
+// Router changes the default Router (currently RouterJSR311)
+func (self *Container) Router(aRouter RouteSelector) {
+	self.router = aRouter
+}
+
+// Add registers a new WebService add it to the ServeMux
+func (self *Container) Add(service *WebService) *Container {
+	self.webServicesLock.Lock()
+	defer self.webServicesLock.Unlock()
+	self.webServices = append(self.webServices, service)
+	if !self.isRegisteredOnRoot {
+		self.ServeMux.HandleFunc("/", self.dispatch)
+		self.isRegisteredOnRoot = true
+	}
+	return self
+}
+
+// RegisteredWebServices returns the WebServices that have been added to this Container.
+func (self *Container) RegisteredWebServices() []*WebService {
+	self.webServicesLock.RLock()
+	defer self.webServicesLock.RUnlock()
+	return append([]*WebService{}, self.webServices...)
+}
+
+// Filter appends a container level FilterFunction. These are called once the router has
+// selected a WebService and Route for a http.Request, ahead of the WebService's and the
+// Route's own filters. A http.Request for which no Route matches does not reach them.
+func (self *Container) Filter(filter FilterFunction) {
+	self.containerFilters = append(self.containerFilters, filter)
+}
+
+// EnableContentEncoding sets the container-wide default for gzip/deflate negotiation of the
+// response body. WebServices and Routes that do not set ContentEncodingEnabled explicitly
+// inherit this value.
+func (self *Container) EnableContentEncoding(enabled bool) *Container {
+	self.contentEncodingEnabled = enabled
+	return self
+}
+
+// ServeHTTP implements the http.Handler interface and dispatches via the ServeMux.
+func (self *Container) ServeHTTP(httpWriter http.ResponseWriter, httpRequest *http.Request) {
+	self.ServeMux.ServeHTTP(httpWriter, httpRequest)
+}
+
+// dispatch selects a Route using the configured router and executes its filter chain.
+func (self *Container) dispatch(httpWriter http.ResponseWriter, httpRequest *http.Request) {
+	self.webServicesLock.RLock()
+	webServices := self.webServices
+	self.webServicesLock.RUnlock()
+
+	webService, route, err := self.router.SelectRoute(webServices, httpRequest)
+	if err != nil {
+		status := http.StatusNotFound
+		if strings.HasPrefix(err.Error(), "[restful] 405:") {
+			status = http.StatusMethodNotAllowed
+		} else if strings.HasPrefix(err.Error(), "[restful] 406:") {
+			status = http.StatusNotAcceptable
+		}
+		writeServiceError(httpWriter, status, err)
+		return
+	}
+	wrappedRequest := NewRequest(httpRequest)
+	for name, value := range route.extractPathParameters(httpRequest.URL.Path) {
+		wrappedRequest.pathParameters[name] = value
+	}
+	writer := httpWriter
+	if self.encodingEnabledFor(webService, route) && httpRequest.Method != http.MethodHead {
+		if encoding := wantsCompressedResponse(httpRequest.Header.Get("Accept-Encoding")); encoding != "" {
+			compressingWriter, err := newCompressingResponseWriter(httpWriter, encoding)
+			if err == nil {
+				defer compressingWriter.Close()
+				writer = compressingWriter
+			}
+		}
+	}
+	wrappedResponse := NewResponse(writer)
+	wrappedResponse.requestAccept = httpRequest.Header.Get("Accept")
+	wrappedResponse.routeProduces = route.Produces
+	if verr := validateParameters(route, wrappedRequest); verr != nil {
+		wrappedResponse.Header().Set("Content-Type", "application/json")
+		wrappedResponse.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(wrappedResponse).Encode(verr)
+		return
+	}
+	allFilters := append(append([]FilterFunction{}, self.containerFilters...), webService.Filters()...)
+	allFilters = append(allFilters, route.Filters...)
+	chain := FilterChain{Filters: allFilters, Target: route.Function}
+	chain.ProcessFilter(wrappedRequest, wrappedResponse)
+}
+
+// encodingEnabledFor resolves the effective ContentEncodingEnabled setting for a Route,
+// falling back to its WebService and then to the Container-wide default.
+func (self *Container) encodingEnabledFor(webService *WebService, route *Route) bool {
+	if route.contentEncodingEnabled != nil {
+		return *route.contentEncodingEnabled
+	}
+	if webService.contentEncodingEnabled != nil {
+		return *webService.contentEncodingEnabled
+	}
+	return self.contentEncodingEnabled
+}
+
+func writeServiceError(httpWriter http.ResponseWriter, status int, err error) {
+	http.Error(httpWriter, err.Error(), status)
+}
@@ -0,0 +1,136 @@
+package restful
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CrossOriginResourceSharing is used to create a Container.Filter (or WebService.Filter) that
+// implements CORS (Cross-Origin-Resource-Sharing) for incoming requests as described in
+// http://www.w3.org/TR/cors/#resource-processing-model
+type CrossOriginResourceSharing struct {
+	ExposeHeaders  []string // list of Header names
+	AllowedHeaders []string // list of Header names
+	AllowedDomains []string // list of allowed values for Http Origin, exact string or regular expression
+	AllowedMethods []string // list of Http methods
+	MaxAge         int      // number of seconds before a preflight request is no longer valid
+	CookiesAllowed bool
+
+	allowedOriginPatterns []*regexp.Regexp // internal cache of compiled regular expressions
+}
+
+// Filter is a restful.FilterFunction that handles both simple and preflight CORS requests.
+// A preflight request reaches Filter only when the router selects a Route for its path and
+// the OPTIONS method.
+// CrossOriginResourceSharing lazily compiles AllowedDomains regular expressions on first use
+// and caches them on itself, so Filter must be bound from a pointer (e.g.
+// `cors := &CrossOriginResourceSharing{...}; ws.Filter(cors.Filter)`) for the cache to survive
+// across requests instead of being rebuilt, and thrown away, on every single one.
+func (c *CrossOriginResourceSharing) Filter(req *Request, resp *Response, chain *FilterChain) {
+	origin := req.Request.Header.Get("Origin")
+	if origin == "" {
+		chain.ProcessFilter(req, resp)
+		return
+	}
+	if !c.isOriginAllowed(origin) {
+		chain.ProcessFilter(req, resp)
+		return
+	}
+	if req.Request.Method == "OPTIONS" && req.Request.Header.Get("Access-Control-Request-Method") != "" {
+		c.doPreflight(req, resp, origin)
+		return
+	}
+	c.doActualRequest(resp, origin)
+	chain.ProcessFilter(req, resp)
+}
+
+func (c *CrossOriginResourceSharing) doActualRequest(resp *Response, origin string) {
+	resp.Header().Set("Access-Control-Allow-Origin", origin)
+	if c.CookiesAllowed {
+		resp.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+	if len(c.ExposeHeaders) > 0 {
+		resp.Header().Set("Access-Control-Expose-Headers", strings.Join(c.ExposeHeaders, ","))
+	}
+}
+
+func (c *CrossOriginResourceSharing) doPreflight(req *Request, resp *Response, origin string) {
+	requestedMethod := req.Request.Header.Get("Access-Control-Request-Method")
+	if !c.isMethodAllowed(requestedMethod) {
+		return
+	}
+	requestedHeaders := req.Request.Header.Get("Access-Control-Request-Headers")
+	if requestedHeaders != "" && !c.areHeadersAllowed(requestedHeaders) {
+		return
+	}
+	resp.Header().Set("Access-Control-Allow-Origin", origin)
+	resp.Header().Set("Access-Control-Allow-Methods", strings.Join(c.AllowedMethods, ","))
+	if requestedHeaders != "" {
+		resp.Header().Set("Access-Control-Allow-Headers", requestedHeaders)
+	}
+	if c.CookiesAllowed {
+		resp.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+	if c.MaxAge > 0 {
+		resp.Header().Set("Access-Control-Max-Age", strconv.Itoa(c.MaxAge))
+	}
+	resp.WriteHeader(200)
+}
+
+func (c *CrossOriginResourceSharing) isOriginAllowed(origin string) bool {
+	if len(c.AllowedDomains) == 0 {
+		return true
+	}
+	if c.allowedOriginPatterns == nil {
+		for _, each := range c.AllowedDomains {
+			if pattern, err := regexp.Compile(each); err == nil {
+				c.allowedOriginPatterns = append(c.allowedOriginPatterns, pattern)
+			}
+		}
+	}
+	for _, each := range c.AllowedDomains {
+		if each == origin {
+			return true
+		}
+	}
+	for _, pattern := range c.allowedOriginPatterns {
+		if pattern.MatchString(origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c CrossOriginResourceSharing) isMethodAllowed(method string) bool {
+	if len(c.AllowedMethods) == 0 {
+		return false
+	}
+	for _, each := range c.AllowedMethods {
+		if each == method {
+			return true
+		}
+	}
+	return false
+}
+
+func (c CrossOriginResourceSharing) areHeadersAllowed(headerList string) bool {
+	if len(c.AllowedHeaders) == 0 {
+		return false
+	}
+	requested := strings.Split(headerList, ",")
+	for _, each := range requested {
+		header := strings.ToLower(strings.TrimSpace(each))
+		allowed := false
+		for _, candidate := range c.AllowedHeaders {
+			if strings.ToLower(candidate) == header {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package restful
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newCORSRequest(method, origin string, extraHeaders map[string]string) *Request {
+	httpRequest := httptest.NewRequest(method, "/", nil)
+	if origin != "" {
+		httpRequest.Header.Set("Origin", origin)
+	}
+	for k, v := range extraHeaders {
+		httpRequest.Header.Set(k, v)
+	}
+	return NewRequest(httpRequest)
+}
+
+func TestCrossOriginResourceSharingPreflight(t *testing.T) {
+	cors := &CrossOriginResourceSharing{
+		AllowedDomains: []string{"http://example.com"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"X-Custom"},
+	}
+	req := newCORSRequest(http.MethodOptions, "http://example.com", map[string]string{
+		"Access-Control-Request-Method":  "POST",
+		"Access-Control-Request-Headers": "X-Custom",
+	})
+	recorder := httptest.NewRecorder()
+	resp := NewResponse(recorder)
+
+	called := false
+	chain := &FilterChain{Target: func(*Request, *Response) { called = true }}
+	cors.Filter(req, resp, chain)
+
+	if called {
+		t.Errorf("expected the preflight request to be short-circuited before the Route function")
+	}
+	if recorder.Code != http.StatusOK {
+		t.Errorf("expected 200 for an allowed preflight, got %d", recorder.Code)
+	}
+	if got := recorder.Header().Get("Access-Control-Allow-Origin"); got != "http://example.com" {
+		t.Errorf("expected Access-Control-Allow-Origin echoed, got %q", got)
+	}
+	if got := recorder.Header().Get("Access-Control-Allow-Methods"); got != "GET,POST" {
+		t.Errorf("expected Access-Control-Allow-Methods GET,POST, got %q", got)
+	}
+}
+
+func TestCrossOriginResourceSharingCachesCompiledPatternsAcrossRequests(t *testing.T) {
+	cors := &CrossOriginResourceSharing{
+		AllowedDomains: []string{"https://.*\\.example\\.com"},
+		AllowedMethods: []string{"GET"},
+	}
+	boundFilter := cors.Filter // simulate ws.Filter(cors.Filter) capturing a bound method value
+
+	for i := 0; i < 3; i++ {
+		req := newCORSRequest(http.MethodGet, "https://api.example.com", nil)
+		resp := NewResponse(httptest.NewRecorder())
+		chain := &FilterChain{Target: func(*Request, *Response) {}}
+		boundFilter(req, resp, chain)
+	}
+
+	if len(cors.allowedOriginPatterns) != 1 {
+		t.Errorf("expected the compiled Origin pattern cache to persist across requests, got %d entries", len(cors.allowedOriginPatterns))
+	}
+}
@@ -0,0 +1,141 @@
+package restful
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// CurlyRouter expresses Routes in a curly syntax: /path/{param}/subpath/{param:[a-z]+}/{subpath:*}
+// It does not use the notion of nested Routes. Matching Routes are ranked by their number of literal
+// segments, then by their number of parameter segments; Routes that tie on both are tried in the order
+// in which they were added. The tail wildcard parameter has to be the last token of the Path.
+// CurlyRouter implements the RouteSelector interface.
+type CurlyRouter struct{}
+
+// curlyMatch holds the outcome of matching a Route's tokens against the request tokens.
+type curlyMatch struct {
+	service       *WebService
+	route         *Route
+	literalCount  int // the number of literal path segments that matched
+	paramCount    int // the number of param (non-wildcard) path segments that matched
+}
+
+// SelectRoute is part of the RouteSelector interface.
+func (self CurlyRouter) SelectRoute(
+	webServices []*WebService,
+	httpRequest *http.Request) (selectedService *WebService, selectedRoute *Route, err error) {
+
+	requestTokens := tokenizePath(httpRequest.URL.Path)
+	matches := []curlyMatch{}
+	for _, each := range webServices {
+		rootTokens := tokenizePath(each.RootPath())
+		if !hasCommonPrefix(requestTokens, rootTokens) {
+			continue
+		}
+		for i := range each.Routes() {
+			route := &each.Routes()[i]
+			if literalCount, paramCount, ok := matchTokens(requestTokens, route.pathParts); ok {
+				matches = append(matches, curlyMatch{each, route, literalCount, paramCount})
+			}
+		}
+	}
+	if len(matches) == 0 {
+		return nil, nil, errors.New("[restful] no Route matches path " + httpRequest.URL.Path)
+	}
+	// prefer the most literal, then the most parameters (fewest wildcards)
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].literalCount != matches[j].literalCount {
+			return matches[i].literalCount > matches[j].literalCount
+		}
+		return matches[i].paramCount > matches[j].paramCount
+	})
+	methodMatches := []curlyMatch{}
+	for _, each := range matches {
+		if each.route.Method == httpRequest.Method {
+			methodMatches = append(methodMatches, each)
+		}
+	}
+	if len(methodMatches) == 0 {
+		return nil, nil, errors.New("[restful] 405: method " + httpRequest.Method + " not allowed on path " + httpRequest.URL.Path)
+	}
+	contentType := httpRequest.Header.Get("Content-Type")
+	accept := httpRequest.Header.Get("Accept")
+	if accept == "" {
+		accept = "*/*"
+	}
+	for _, each := range methodMatches {
+		if each.route.matchesContentType(contentType) && each.route.matchesAccept(accept) {
+			return each.service, each.route, nil
+		}
+	}
+	return nil, nil, errors.New("[restful] 406: no Route matches Accept " + accept)
+}
+
+// hasCommonPrefix tells whether requestTokens starts with rootTokens.
+func hasCommonPrefix(requestTokens, rootTokens []string) bool {
+	if len(rootTokens) > len(requestTokens) {
+		return false
+	}
+	for i, each := range rootTokens {
+		if each != requestTokens[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// matchTokens matches the tokens of an incoming request path against the tokens of a Route.
+// It returns the number of literal and parameter matches, and whether the whole path matched.
+func matchTokens(requestTokens, routeTokens []string) (literalCount, paramCount int, matched bool) {
+	for i, routeToken := range routeTokens {
+		if isWildCardToken(routeToken) {
+			// tail wildcard, matches the rest of the path (zero or more tokens)
+			return literalCount, paramCount, true
+		}
+		if i >= len(requestTokens) {
+			return 0, 0, false
+		}
+		switch {
+		case isParamToken(routeToken):
+			if !paramTokenMatches(routeToken, requestTokens[i]) {
+				return 0, 0, false
+			}
+			paramCount++
+		case routeToken == requestTokens[i]:
+			literalCount++
+		default:
+			return 0, 0, false
+		}
+	}
+	if len(requestTokens) != len(routeTokens) {
+		return 0, 0, false
+	}
+	return literalCount, paramCount, true
+}
+
+func isParamToken(token string) bool {
+	return strings.HasPrefix(token, "{") && strings.HasSuffix(token, "}")
+}
+
+func isWildCardToken(token string) bool {
+	if !isParamToken(token) {
+		return false
+	}
+	spec := token[1 : len(token)-1]
+	colon := strings.Index(spec, ":")
+	return colon != -1 && spec[colon+1:] == "*"
+}
+
+func paramTokenMatches(routeToken, value string) bool {
+	spec := routeToken[1 : len(routeToken)-1]
+	colon := strings.Index(spec, ":")
+	if colon == -1 {
+		return true // {name} matches any single segment
+	}
+	pattern := spec[colon+1:]
+	matched, err := regexp.MatchString("^"+pattern+"$", value)
+	return err == nil && matched
+}
@@ -0,0 +1,50 @@
+package restful
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCurlyRouterMatchesNamedAndRegexParameters(t *testing.T) {
+	ws := new(WebService)
+	ws.Path("/users")
+	ws.Route(ws.GET("/{id:[0-9]+}").To(dummyRouteFunction))
+	ws.Route(ws.GET("/{name}").To(dummyRouteFunction))
+
+	httpRequest := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	_, route, err := CurlyRouter{}.SelectRoute([]*WebService{ws}, httpRequest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if route.Path != "/users/{id:[0-9]+}" {
+		t.Errorf("expected the regex route, added first, to win the tie with the named route, got %s", route.Path)
+	}
+}
+
+func TestCurlyRouterMatchesTailWildcard(t *testing.T) {
+	ws := new(WebService)
+	ws.Path("/static")
+	ws.Route(ws.GET("/{subpath:*}").To(dummyRouteFunction))
+
+	httpRequest := httptest.NewRequest(http.MethodGet, "/static/css/app.css", nil)
+	_, route, err := CurlyRouter{}.SelectRoute([]*WebService{ws}, httpRequest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if route.Path != "/static/{subpath:*}" {
+		t.Errorf("expected the wildcard route to match, got %s", route.Path)
+	}
+}
+
+func TestCurlyRouterNoMatch(t *testing.T) {
+	ws := new(WebService)
+	ws.Path("/users")
+	ws.Route(ws.GET("/{id:[0-9]+}").To(dummyRouteFunction))
+
+	httpRequest := httptest.NewRequest(http.MethodGet, "/users/abc/extra", nil)
+	_, _, err := CurlyRouter{}.SelectRoute([]*WebService{ws}, httpRequest)
+	if err == nil {
+		t.Fatalf("expected an error, the request path has too many segments")
+	}
+}
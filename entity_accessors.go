@@ -0,0 +1,188 @@
+package restful
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// EntityReaderWriter can read and write values using a particular wire representation
+// (its associated MIME type, e.g. application/json). Third parties can provide their own,
+// e.g. for application/x-protobuf or application/msgpack, and register it using
+// RegisterEntityAccessor.
+type EntityReaderWriter interface {
+	// Read unmarshals the request body into the value pointed to by v.
+	Read(req *Request, v interface{}) error
+	// Write marshals v and writes it, with the given Http status, to the response.
+	Write(resp *Response, status int, v interface{}) error
+}
+
+var (
+	entityAccessorsLock sync.RWMutex
+	entityAccessors     = map[string]EntityReaderWriter{}
+)
+
+func init() {
+	RegisterEntityAccessor("application/json", jsonEntityAccessor{})
+	RegisterEntityAccessor("application/xml", xmlEntityAccessor{})
+}
+
+// RegisterEntityAccessor adds (or replaces) the EntityReaderWriter used for a mime type.
+func RegisterEntityAccessor(mime string, eaw EntityReaderWriter) {
+	entityAccessorsLock.Lock()
+	defer entityAccessorsLock.Unlock()
+	entityAccessors[mime] = eaw
+}
+
+// entityAccessorFor returns the registered EntityReaderWriter for a mime type, if any.
+func entityAccessorFor(mime string) (EntityReaderWriter, bool) {
+	entityAccessorsLock.RLock()
+	defer entityAccessorsLock.RUnlock()
+	eaw, ok := entityAccessors[mime]
+	return eaw, ok
+}
+
+// jsonEntityAccessor is the built-in EntityReaderWriter for application/json.
+type jsonEntityAccessor struct{}
+
+func (jsonEntityAccessor) Read(req *Request, v interface{}) error {
+	return json.NewDecoder(req.Request.Body).Decode(v)
+}
+
+func (jsonEntityAccessor) Write(resp *Response, status int, v interface{}) error {
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(status)
+	return json.NewEncoder(resp).Encode(v)
+}
+
+// xmlEntityAccessor is the built-in EntityReaderWriter for application/xml.
+type xmlEntityAccessor struct{}
+
+func (xmlEntityAccessor) Read(req *Request, v interface{}) error {
+	return xml.NewDecoder(req.Request.Body).Decode(v)
+}
+
+func (xmlEntityAccessor) Write(resp *Response, status int, v interface{}) error {
+	resp.Header().Set("Content-Type", "application/xml")
+	resp.WriteHeader(status)
+	return xml.NewEncoder(resp).Encode(v)
+}
+
+// UnsupportedMediaTypeError is returned by Request.ReadEntity when no EntityReaderWriter
+// is registered for the request's Content-Type. Its message names status 415; no response
+// status is written for it.
+type UnsupportedMediaTypeError struct {
+	ContentType string
+}
+
+func (e UnsupportedMediaTypeError) Error() string {
+	return "[restful] 415: no EntityReaderWriter registered for Content-Type " + e.ContentType
+}
+
+// NotAcceptableError is returned by Response.WriteEntity when none of the MIME types in the
+// request's Accept header has a registered EntityReaderWriter. WriteEntity writes the 406
+// response status itself before returning it.
+type NotAcceptableError struct {
+	Accept string
+}
+
+func (e NotAcceptableError) Error() string {
+	return "[restful] 406: no EntityReaderWriter registered for Accept " + e.Accept
+}
+
+// WriteEntity marshals value using the EntityReaderWriter negotiated (by q-value, against
+// the matched Route's Produces and the request's Accept header) for this Response, and
+// writes it with the given Http status. Returns a NotAcceptableError if no accessor matches.
+func (self *Response) WriteEntity(status int, value interface{}) error {
+	accessor, ok := self.negotiatedAccessor()
+	if !ok {
+		self.WriteHeader(http.StatusNotAcceptable)
+		return NotAcceptableError{Accept: self.requestAccept}
+	}
+	return accessor.Write(self, status, value)
+}
+
+// negotiatedAccessor picks the best EntityReaderWriter for this Response's requestAccept,
+// restricted to the Route's Produces list (if any), per RFC 7231 q-value ordering.
+func (self *Response) negotiatedAccessor() (EntityReaderWriter, bool) {
+	accept := self.requestAccept
+	if accept == "" {
+		accept = "*/*"
+	}
+	for _, candidate := range sortedMimesOf(accept) {
+		if candidate.q <= 0 {
+			continue
+		}
+		if candidate.mime == "*/*" {
+			if mime, ok := self.firstProducedMime(); ok {
+				if accessor, found := entityAccessorFor(mime); found {
+					return accessor, true
+				}
+			}
+			continue
+		}
+		if len(self.routeProduces) > 0 && !self.produces(candidate.mime) {
+			continue
+		}
+		if accessor, found := entityAccessorFor(candidate.mime); found {
+			return accessor, true
+		}
+	}
+	return nil, false
+}
+
+func (self *Response) produces(mime string) bool {
+	for _, each := range self.routeProduces {
+		if each == mime || each == "*/*" {
+			return true
+		}
+	}
+	return false
+}
+
+func (self *Response) firstProducedMime() (string, bool) {
+	if len(self.routeProduces) == 0 {
+		return "application/json", true
+	}
+	return self.routeProduces[0], true
+}
+
+// qValueMime is a single entry of a parsed Accept header.
+type qValueMime struct {
+	mime string
+	q    float64
+}
+
+// sortedMimesOf parses a header value such as "application/json;q=0.9,application/xml"
+// per RFC 7231 and returns the mime types ordered from most to least preferred.
+func sortedMimesOf(header string) []qValueMime {
+	parts := strings.Split(header, ",")
+	parsed := make([]qValueMime, 0, len(parts))
+	for _, each := range parts {
+		fields := strings.Split(each, ";")
+		mime := strings.TrimSpace(fields[0])
+		if mime == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if parsedQ, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = parsedQ
+				}
+			}
+		}
+		parsed = append(parsed, qValueMime{mime, q})
+	}
+	// stable sort, highest q first
+	for i := 1; i < len(parsed); i++ {
+		for j := i; j > 0 && parsed[j].q > parsed[j-1].q; j-- {
+			parsed[j], parsed[j-1] = parsed[j-1], parsed[j]
+		}
+	}
+	return parsed
+}
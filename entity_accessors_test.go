@@ -0,0 +1,75 @@
+package restful
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type entityAccessorTestItem struct {
+	Name string `json:"name"`
+}
+
+func TestResponseWriteEntityNegotiatesAcceptWithQValues(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	resp := NewResponse(recorder)
+	resp.requestAccept = "application/xml;q=0.5, application/json;q=0.9"
+	resp.routeProduces = []string{"application/json", "application/xml"}
+
+	if err := resp.WriteEntity(200, entityAccessorTestItem{Name: "box"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := recorder.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("expected application/json to be chosen by q-value, got %s", got)
+	}
+	if !strings.Contains(recorder.Body.String(), "box") {
+		t.Errorf("expected the encoded entity in the body, got %s", recorder.Body.String())
+	}
+}
+
+func TestResponseWriteEntityNotAcceptable(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	resp := NewResponse(recorder)
+	resp.requestAccept = "application/x-protobuf"
+	resp.routeProduces = []string{"application/json"}
+
+	err := resp.WriteEntity(200, entityAccessorTestItem{Name: "box"})
+	if err == nil {
+		t.Fatalf("expected a NotAcceptableError when no accessor matches Accept")
+	}
+	if _, ok := err.(NotAcceptableError); !ok {
+		t.Errorf("expected a NotAcceptableError, got %T", err)
+	}
+	if recorder.Code != 406 {
+		t.Errorf("expected a 406 response, got %d", recorder.Code)
+	}
+}
+
+func TestRequestReadEntityUnsupportedMediaType(t *testing.T) {
+	httpRequest := httptest.NewRequest("POST", "/", strings.NewReader("irrelevant"))
+	httpRequest.Header.Set("Content-Type", "application/x-protobuf")
+	req := NewRequest(httpRequest)
+
+	var target entityAccessorTestItem
+	err := req.ReadEntity(&target)
+	if err == nil {
+		t.Fatalf("expected an UnsupportedMediaTypeError for an unregistered Content-Type")
+	}
+	if _, ok := err.(UnsupportedMediaTypeError); !ok {
+		t.Errorf("expected an UnsupportedMediaTypeError, got %T", err)
+	}
+}
+
+func TestRequestReadEntityJSON(t *testing.T) {
+	httpRequest := httptest.NewRequest("POST", "/", strings.NewReader(`{"name":"box"}`))
+	httpRequest.Header.Set("Content-Type", "application/json")
+	req := NewRequest(httpRequest)
+
+	var target entityAccessorTestItem
+	if err := req.ReadEntity(&target); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if target.Name != "box" {
+		t.Errorf("expected Name box, got %s", target.Name)
+	}
+}
@@ -0,0 +1,24 @@
+package restful
+
+// FilterChain is a request scoped object that allows a Filter to pass on
+// the request,response pair to the next Filter or RouteFunction.
+type FilterChain struct {
+	Filters []FilterFunction // ordered list of FilterFunction
+	Index   int              // index into Filters that is currently in progress
+	Target  RouteFunction    // function to call after passing all filters
+}
+
+// ProcessFilter passes the request,response pair through the next of Filters.
+// Each Filter is responsible for calling ProcessFilter(req,resp) on the FilterChain
+// if it wants to pass on the execution to the next Filter or RouteFunction.
+func (self *FilterChain) ProcessFilter(request *Request, response *Response) {
+	if self.Index < len(self.Filters) {
+		self.Index++
+		self.Filters[self.Index-1](request, response, self)
+	} else {
+		self.Target(request, response)
+	}
+}
+
+// FilterFunction definitions must call ProcessFilter on the FilterChain to pass on the control and eventually call the RouteFunction
+type FilterFunction func(*Request, *Response, *FilterChain)
@@ -0,0 +1,89 @@
+package restful
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// RouterJSR311 implements the flow for matching Requests to Routes (and consequently Resource Methods)
+// as specified by the JSR311 http://jsr311.java.net/nonav/releases/1.1/spec/spec.html.
+// RouterJSR311 implements the RouteSelector interface.
+// Container (1) uses this type of router by default.
+type RouterJSR311 struct{}
+
+// SelectRoute is part of the RouteSelector interface.
+// Selects from a list of WebService the matching one and from that one its Route.
+func (self RouterJSR311) SelectRoute(
+	webServices []*WebService,
+	httpRequest *http.Request) (selectedService *WebService, selectedRoute *Route, err error) {
+
+	// Step 1: filter out WebServices whose root path is not a prefix of the URL path.
+	// The root only narrows down the candidates; Step 2 matches the full Route path, so
+	// this must be a prefix test and not a full match (a WebService rooted at "/users"
+	// must still be a candidate for "/users/42").
+	requestPath := httpRequest.URL.Path
+	candidates := []*WebService{}
+	for _, each := range webServices {
+		if rootMatchesPrefix(each.RootPath(), requestPath) {
+			candidates = append(candidates, each)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil, errors.New("[restful] no WebService matches path " + requestPath)
+	}
+	// Step 2: collect candidate routes across all matching WebServices
+	type scoredRoute struct {
+		service *WebService
+		route   *Route
+	}
+	matches := []scoredRoute{}
+	for _, ws := range candidates {
+		for i := range ws.Routes() {
+			route := &ws.Routes()[i]
+			if route.pathExpr.Matcher.MatchString(requestPath) {
+				matches = append(matches, scoredRoute{ws, route})
+			}
+		}
+	}
+	if len(matches) == 0 {
+		return nil, nil, errors.New("[restful] no Route matches path " + requestPath)
+	}
+	// Step 3: filter on Http method
+	methodMatches := []scoredRoute{}
+	for _, each := range matches {
+		if each.route.Method == httpRequest.Method {
+			methodMatches = append(methodMatches, each)
+		}
+	}
+	if len(methodMatches) == 0 {
+		return nil, nil, errors.New("[restful] 405: method " + httpRequest.Method + " not allowed on path " + requestPath)
+	}
+	// Step 4: filter on content-type and accept, preferring the most specific path
+	sort.SliceStable(methodMatches, func(i, j int) bool {
+		return methodMatches[i].route.pathExpr.LiteralCount > methodMatches[j].route.pathExpr.LiteralCount
+	})
+	contentType := httpRequest.Header.Get("Content-Type")
+	accept := httpRequest.Header.Get("Accept")
+	if accept == "" {
+		accept = "*/*"
+	}
+	for _, each := range methodMatches {
+		if each.route.matchesContentType(contentType) && each.route.matchesAccept(accept) {
+			return each.service, each.route, nil
+		}
+	}
+	return nil, nil, errors.New("[restful] 406: no Route matches Accept " + accept)
+}
+
+// rootMatchesPrefix reports whether requestPath is rooted at root: either equal to root
+// (modulo a trailing slash) or continuing with a "/" into further path segments. root "/"
+// and "" match every requestPath.
+func rootMatchesPrefix(root, requestPath string) bool {
+	trimmedRoot := strings.TrimRight(root, "/")
+	if trimmedRoot == "" {
+		return true
+	}
+	return requestPath == trimmedRoot || strings.HasPrefix(requestPath, trimmedRoot+"/")
+}
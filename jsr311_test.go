@@ -0,0 +1,51 @@
+package restful
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func dummyRouteFunction(*Request, *Response) {}
+
+func TestRouterJSR311SelectsRouteOnRootWebService(t *testing.T) {
+	ws := new(WebService)
+	ws.Path("/")
+	ws.Route(ws.GET("/items").To(dummyRouteFunction))
+
+	httpRequest := httptest.NewRequest(http.MethodGet, "/items", nil)
+	_, route, err := RouterJSR311{}.SelectRoute([]*WebService{ws}, httpRequest)
+	if err != nil {
+		t.Fatalf("expected a matching Route for /items on a WebService rooted at \"/\", got error: %v", err)
+	}
+	if route.Path != "/items" {
+		t.Errorf("expected route Path /items, got %s", route.Path)
+	}
+}
+
+func TestRouterJSR311SelectsRouteOnNonRootWebService(t *testing.T) {
+	ws := new(WebService)
+	ws.Path("/users")
+	ws.Route(ws.GET("/{id}").To(dummyRouteFunction))
+
+	httpRequest := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	_, route, err := RouterJSR311{}.SelectRoute([]*WebService{ws}, httpRequest)
+	if err != nil {
+		t.Fatalf("expected a matching Route for /users/42 on a WebService rooted at \"/users\", got error: %v", err)
+	}
+	if route.Path != "/users/{id}" {
+		t.Errorf("expected route Path /users/{id}, got %s", route.Path)
+	}
+}
+
+func TestRouterJSR311NoMatchingPath(t *testing.T) {
+	ws := new(WebService)
+	ws.Path("/")
+	ws.Route(ws.GET("/items").To(dummyRouteFunction))
+
+	httpRequest := httptest.NewRequest(http.MethodGet, "/unknown", nil)
+	_, _, err := RouterJSR311{}.SelectRoute([]*WebService{ws}, httpRequest)
+	if err == nil {
+		t.Fatalf("expected an error for an unmatched path")
+	}
+}
@@ -0,0 +1,78 @@
+package restful
+
+const (
+	PATH_PARAMETER = iota
+	QUERY_PARAMETER
+	BODY_PARAMETER
+	HEADER_PARAMETER
+)
+
+// Parameter is a documented parameter for a Route.
+type Parameter struct {
+	*ParameterData
+}
+
+// ParameterData represents the data for a Parameter.
+type ParameterData struct {
+	Name, Description, DataType string
+	Kind                        int
+	Required                    bool
+	AllowMultiple               bool
+	DefaultValue                string
+	AllowableValues             map[string]string
+}
+
+// bePath sets the parameter kind to PATH_PARAMETER.
+func (self *Parameter) bePath() {
+	self.Kind = PATH_PARAMETER
+}
+
+// beQuery sets the parameter kind to QUERY_PARAMETER.
+func (self *Parameter) beQuery() {
+	self.Kind = QUERY_PARAMETER
+}
+
+// beBody sets the parameter kind to BODY_PARAMETER.
+func (self *Parameter) beBody() {
+	self.Kind = BODY_PARAMETER
+}
+
+// beHeader sets the parameter kind to HEADER_PARAMETER.
+func (self *Parameter) beHeader() {
+	self.Kind = HEADER_PARAMETER
+}
+
+// KindOf returns the parameter kind (PATH_PARAMETER, QUERY_PARAMETER, ...)
+func (self Parameter) KindOf() int {
+	return self.ParameterData.Kind
+}
+
+// DataType sets the datatype of the parameter for documentation purposes.
+func (self *Parameter) DataType(typeName string) *Parameter {
+	self.ParameterData.DataType = typeName
+	return self
+}
+
+// AllowMultiple indicates this parameter may occur multiple times.
+func (self *Parameter) AllowMultiple(multiple bool) *Parameter {
+	self.ParameterData.AllowMultiple = multiple
+	return self
+}
+
+// AllowableValues sets a map of allowable values for this parameter.
+func (self *Parameter) AllowableValues(values map[string]string) *Parameter {
+	self.ParameterData.AllowableValues = values
+	return self
+}
+
+// DefaultValue sets the default value of this parameter.
+func (self *Parameter) DefaultValue(value string) *Parameter {
+	self.ParameterData.DefaultValue = value
+	return self
+}
+
+// Required sets whether this parameter is required.
+func (self *Parameter) Required(required bool) *Parameter {
+	self.ParameterData.Required = required
+	return self
+}
@@ -0,0 +1,66 @@
+package restful
+
+// ParameterError documents a single Parameter that failed validation.
+type ParameterError struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// ValidationError is the structured 400 response body written when a Route's declared
+// Parameters (Required, AllowableValues) are violated by the incoming Request.
+type ValidationError struct {
+	Errors []ParameterError `json:"errors"`
+}
+
+func (self ValidationError) Error() string {
+	message := "[restful] parameter validation failed"
+	for _, each := range self.Errors {
+		message += "; " + each.Name + ": " + each.Message
+	}
+	return message
+}
+
+// validateParameters checks the Request against the Route's declared ParameterDocs,
+// returning a ValidationError (never nil Errors if non-nil) when a Required parameter is
+// missing or an AllowableValues constraint is violated.
+func validateParameters(route *Route, request *Request) *ValidationError {
+	var errs []ParameterError
+	for _, p := range route.ParameterDocs {
+		value, present := parameterValue(p, request)
+		if !present {
+			if p.ParameterData.Required {
+				errs = append(errs, ParameterError{Name: p.Name, Message: "required parameter is missing"})
+			}
+			continue
+		}
+		if len(p.ParameterData.AllowableValues) > 0 {
+			if _, ok := p.ParameterData.AllowableValues[value]; !ok {
+				errs = append(errs, ParameterError{Name: p.Name, Message: "value is not one of the allowable values"})
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: errs}
+}
+
+// parameterValue returns the value of a Parameter on a Request and whether it was present.
+func parameterValue(p *Parameter, request *Request) (string, bool) {
+	switch p.KindOf() {
+	case PATH_PARAMETER:
+		value, ok := request.PathParameters()[p.Name]
+		return value, ok
+	case QUERY_PARAMETER:
+		values := request.Request.URL.Query()[p.Name]
+		if len(values) == 0 {
+			return "", false
+		}
+		return values[0], true
+	case HEADER_PARAMETER:
+		value := request.HeaderParameter(p.Name)
+		return value, value != ""
+	default: // BODY_PARAMETER is validated by ReadEntity, not here
+		return "", true
+	}
+}
@@ -0,0 +1,46 @@
+package restful
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidateParameters(t *testing.T) {
+	ws := new(WebService)
+	ws.Path("/items")
+	builder := ws.GET("/{id}").To(dummyRouteFunction).
+		Param((&Parameter{&ParameterData{Name: "id", Kind: PATH_PARAMETER, Required: true}})).
+		Param((&Parameter{&ParameterData{Name: "color", Kind: QUERY_PARAMETER,
+			AllowableValues: map[string]string{"red": "red", "blue": "blue"}}}))
+	ws.Route(builder)
+	route := ws.Routes()[0]
+
+	cases := []struct {
+		name        string
+		pathParams  map[string]string
+		query       string
+		expectValid bool
+	}{
+		{"missing required path parameter", map[string]string{}, "", false},
+		{"required path parameter present, no query", map[string]string{"id": "42"}, "", true},
+		{"allowable query value", map[string]string{"id": "42"}, "color=red", true},
+		{"disallowed query value", map[string]string{"id": "42"}, "color=green", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			httpRequest := httptest.NewRequest("GET", "/items/1?"+c.query, nil)
+			req := NewRequest(httpRequest)
+			for k, v := range c.pathParams {
+				req.pathParameters[k] = v
+			}
+			err := validateParameters(&route, req)
+			if c.expectValid && err != nil {
+				t.Errorf("expected no validation error, got %v", err)
+			}
+			if !c.expectValid && err == nil {
+				t.Errorf("expected a validation error, got none")
+			}
+		})
+	}
+}
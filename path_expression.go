@@ -0,0 +1,92 @@
+package restful
+
+import (
+	"regexp"
+	"strings"
+)
+
+// PathExpression holds a compiled regular expression for a path template
+// such as "/users/{id}" or "/users/{id:[0-9]+}".
+type PathExpression struct {
+	LiteralCount int      // number of literal characters (means those not resulting from template variable substitution)
+	VarNames     []string // the names of parameters (enclosed by {}) in the path
+	VarCount     int      // number of named parameters (enclosed by {}) in the path
+	Matcher      *regexp.Regexp
+	Source       string // Path as defined by the WebService
+}
+
+// NewPathExpression creates a PathExpression from the input URL path.
+// Returns an error if the path is an invalid Path Template.
+func NewPathExpression(path string) (*PathExpression, error) {
+	varNames := []string{}
+	literalCount := 0
+	token := ""
+	inToken := false
+	for _, each := range path {
+		switch each {
+		case '{':
+			inToken = true
+			token = ""
+		case '}':
+			inToken = false
+			varNames = append(varNames, varName(token))
+			token = ""
+		default:
+			if inToken {
+				token += string(each)
+			} else {
+				literalCount++
+			}
+		}
+	}
+	matcher, err := regexp.Compile(templateToRegularExpression(path))
+	if err != nil {
+		return nil, err
+	}
+	return &PathExpression{literalCount, varNames, len(varNames), matcher, path}, nil
+}
+
+// varName strips an optional ":regexp" suffix from a {name} or {name:regexp} token.
+func varName(token string) string {
+	colon := strings.Index(token, ":")
+	if colon == -1 {
+		return token
+	}
+	return token[:colon]
+}
+
+func templateToRegularExpression(template string) string {
+	if template == "" || template == "/" {
+		// the root path must match the literal "/" (and the empty path, which is what
+		// RouteBuilder.Build passes for a Route at "/" after trimming its trailing slash)
+		return "^/?$"
+	}
+	var buffer strings.Builder
+	buffer.WriteString("^")
+	part := strings.Split(template, "/")
+	for _, each := range part {
+		if len(each) == 0 {
+			continue
+		}
+		buffer.WriteString("/")
+		if strings.HasPrefix(each, "{") {
+			// either {name} or {name:regexp}
+			spec := strings.TrimRight(strings.TrimLeft(each, "{"), "}")
+			colon := strings.Index(spec, ":")
+			if colon == -1 {
+				buffer.WriteString("([^/]+?)")
+			} else if spec[colon+1:] == "*" {
+				// tail wildcard, e.g. {subpath:*}, matches the remainder of the path
+				buffer.WriteString("(.*)")
+			} else {
+				buffer.WriteString("(")
+				buffer.WriteString(spec[colon+1:])
+				buffer.WriteString(")")
+			}
+		} else {
+			buffer.WriteString(regexp.QuoteMeta(each))
+		}
+	}
+	buffer.WriteString("$")
+	return buffer.String()
+}
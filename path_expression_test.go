@@ -0,0 +1,33 @@
+package restful
+
+import "testing"
+
+func TestNewPathExpressionRootMatchesRequestPath(t *testing.T) {
+	expr, err := NewPathExpression("/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !expr.Matcher.MatchString("/") {
+		t.Errorf("expected root PathExpression to match \"/\"")
+	}
+	if expr.Matcher.MatchString("/items") {
+		t.Errorf("expected root PathExpression not to match \"/items\"")
+	}
+}
+
+func TestNewPathExpressionNamedParameter(t *testing.T) {
+	expr, err := NewPathExpression("/users/{id}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	matches := expr.Matcher.FindStringSubmatch("/users/42")
+	if matches == nil {
+		t.Fatalf("expected a match for /users/42")
+	}
+	if len(expr.VarNames) != 1 || expr.VarNames[0] != "id" {
+		t.Errorf("expected VarNames [id], got %v", expr.VarNames)
+	}
+	if matches[1] != "42" {
+		t.Errorf("expected captured id 42, got %s", matches[1])
+	}
+}
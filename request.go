@@ -0,0 +1,73 @@
+package restful
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Request is a wrapper for a http Request that provides convenience methods
+type Request struct {
+	Request        *http.Request
+	pathParameters map[string]string
+}
+
+func NewRequest(httpRequest *http.Request) *Request {
+	return &Request{httpRequest, map[string]string{}}
+}
+
+// PathParameter accesses the Path parameter value by its name
+func (self *Request) PathParameter(name string) string {
+	return self.pathParameters[name]
+}
+
+// PathParameters accesses the Path parameter values
+func (self *Request) PathParameters() map[string]string {
+	return self.pathParameters
+}
+
+// QueryParameter returns the (first) Query parameter value by its name
+func (self *Request) QueryParameter(name string) string {
+	return self.Request.FormValue(name)
+}
+
+// HeaderParameter returns the Http header value by its name
+func (self *Request) HeaderParameter(name string) string {
+	return self.Request.Header.Get(name)
+}
+
+// PathParameterInt parses the named Path parameter as an int.
+func (self *Request) PathParameterInt(name string) (int, error) {
+	return strconv.Atoi(self.PathParameter(name))
+}
+
+// QueryParameterInt parses the named Query parameter as an int.
+func (self *Request) QueryParameterInt(name string) (int, error) {
+	return strconv.Atoi(self.QueryParameter(name))
+}
+
+// QueryParameterBool parses the named Query parameter as a bool.
+func (self *Request) QueryParameterBool(name string) (bool, error) {
+	return strconv.ParseBool(self.QueryParameter(name))
+}
+
+// QueryParameterFloat64 parses the named Query parameter as a float64.
+func (self *Request) QueryParameterFloat64(name string) (float64, error) {
+	return strconv.ParseFloat(self.QueryParameter(name), 64)
+}
+
+// ReadEntity unmarshals the request body into entityPointer using the EntityReaderWriter
+// registered for the request's Content-Type. Returns an UnsupportedMediaTypeError if no
+// accessor is registered for that type.
+func (self *Request) ReadEntity(entityPointer interface{}) error {
+	contentType := self.Request.Header.Get("Content-Type")
+	mime := strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0])
+	if mime == "" {
+		mime = "application/json"
+	}
+	accessor, ok := entityAccessorFor(mime)
+	if !ok {
+		return UnsupportedMediaTypeError{ContentType: mime}
+	}
+	return accessor.Read(self, entityPointer)
+}
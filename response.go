@@ -0,0 +1,35 @@
+package restful
+
+import (
+	"net/http"
+)
+
+// Response is a wrapper for a http ResponseWriter that provides convenience methods
+type Response struct {
+	http.ResponseWriter
+	statusCode    int      // keep status code for debugging purposes
+	requestAccept string   // Accept header of the request that produced this Response
+	routeProduces []string // the MIME types the matched Route declared it can produce
+}
+
+func NewResponse(httpWriter http.ResponseWriter) *Response {
+	return &Response{ResponseWriter: httpWriter, statusCode: http.StatusOK}
+}
+
+// WriteHeader is overridden to remember the status code that was sent.
+func (self *Response) WriteHeader(httpStatus int) {
+	self.statusCode = httpStatus
+	self.ResponseWriter.WriteHeader(httpStatus)
+}
+
+// StatusCode returns the code that has been written using WriteHeader.
+func (self Response) StatusCode() int {
+	return self.statusCode
+}
+
+// WriteError writes the given Http status and the error's message as the response body.
+func (self *Response) WriteError(httpStatus int, err error) error {
+	self.WriteHeader(httpStatus)
+	_, writeErr := self.Write([]byte(err.Error()))
+	return writeErr
+}
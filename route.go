@@ -0,0 +1,112 @@
+package restful
+
+import (
+	"strings"
+)
+
+// RouteFunction declares the signature of a function that can be bound to a Route.
+type RouteFunction func(*Request, *Response)
+
+// Route binds a HTTP Method,Path,Consumes combination to a RouteFunction.
+type Route struct {
+	Method          string
+	Produces        []string
+	Consumes        []string
+	Path            string
+	Function        RouteFunction
+	Filters         []FilterFunction
+	relativePath    string
+	pathParts       []string
+	pathExpr        *PathExpression // cached compilation of relativePath as RegExp
+	contentEncodingEnabled *bool    // nil means: inherit from WebService, then Container
+	Doc             string
+	Notes           string
+	Operation       string
+	ParameterDocs   []*Parameter
+	ReadSample      interface{}
+	WriteSample     interface{}
+	ResponseErrors  map[int]ResponseError
+}
+
+// ResponseError documents a single (non-2xx, typically) response a Route may return.
+type ResponseError struct {
+	Code    int
+	Message string
+	Model   interface{}
+}
+
+// Initialize for Route
+func (self *Route) postBuild() {
+	self.pathParts = tokenizePath(self.Path)
+}
+
+// tokenizePath splits a URL path into its segments, discarding empty tokens.
+func tokenizePath(path string) []string {
+	tokens := []string{}
+	for _, each := range strings.Split(path, "/") {
+		if each != "" {
+			tokens = append(tokens, each)
+		}
+	}
+	return tokens
+}
+
+// extractPathParameters matches requestPath against the Route's compiled path expression
+// and returns the named path parameters ({name} / {name:regexp}) it captured, if any.
+func (self Route) extractPathParameters(requestPath string) map[string]string {
+	params := map[string]string{}
+	if self.pathExpr == nil {
+		return params
+	}
+	matches := self.pathExpr.Matcher.FindStringSubmatch(requestPath)
+	if matches == nil {
+		return params
+	}
+	for i, name := range self.pathExpr.VarNames {
+		if i+1 < len(matches) {
+			params[name] = matches[i+1]
+		}
+	}
+	return params
+}
+
+// matchesContentType returns whether this Route supports the given mime type; * matches any.
+func (self Route) matchesContentType(mimeTypes string) bool {
+	if len(self.Consumes) == 0 {
+		// route does not specify what it can consume, so any type is ok
+		return true
+	}
+	parts := strings.Split(mimeTypes, ",")
+	for _, each := range parts {
+		mimeType := strings.TrimSpace(strings.SplitN(each, ";", 2)[0])
+		if mimeType == "*/*" {
+			return true
+		}
+		for _, consumeable := range self.Consumes {
+			if consumeable == "*/*" || consumeable == mimeType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesAccept returns whether this Route can produce a response for the given Accept header; * matches any.
+func (self Route) matchesAccept(accept string) bool {
+	if len(self.Produces) == 0 {
+		return true
+	}
+	parts := strings.Split(accept, ",")
+	for _, each := range parts {
+		accepted := strings.TrimSpace(strings.SplitN(each, ";", 2)[0])
+		if accepted == "*/*" {
+			return true
+		}
+		for _, producible := range self.Produces {
+			if producible == "*/*" || producible == accepted {
+				return true
+			}
+		}
+	}
+	return false
+}
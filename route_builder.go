@@ -0,0 +1,178 @@
+package restful
+
+import (
+	"log"
+	"strings"
+)
+
+// RouteBuilder is a helper to construct Routes.
+type RouteBuilder struct {
+	rootPath    string
+	currentPath string
+	produces    []string
+	consumes    []string
+	httpMethod  string // required
+	function    RouteFunction
+	filters     []FilterFunction
+	parameters  []*Parameter
+	contentEncodingEnabled *bool
+	doc         string
+	notes       string
+	operation   string
+	readSample  interface{}
+	writeSample interface{}
+	errorMap    map[int]ResponseError
+}
+
+// servicePath sets the rootPath of the WebService this RouteBuilder belongs to.
+func (self *RouteBuilder) servicePath(path string) *RouteBuilder {
+	self.rootPath = path
+	return self
+}
+
+// Method specifies what HTTP method to match. Required.
+func (self *RouteBuilder) Method(method string) *RouteBuilder {
+	self.httpMethod = method
+	return self
+}
+
+// Path specifies the relative (w.r.t WebService root path) URL path to match. Default "/".
+func (self *RouteBuilder) Path(subPath string) *RouteBuilder {
+	self.currentPath = subPath
+	return self
+}
+
+// To bind the route to a function. Required.
+func (self *RouteBuilder) To(function RouteFunction) *RouteBuilder {
+	self.function = function
+	return self
+}
+
+// Produces specifies what MIME types can be produced by this route.
+func (self *RouteBuilder) Produces(mimeTypes ...string) *RouteBuilder {
+	self.produces = mimeTypes
+	return self
+}
+
+// Consumes specifies what MIME types can be consumed by this route.
+func (self *RouteBuilder) Consumes(mimeTypes ...string) *RouteBuilder {
+	self.consumes = mimeTypes
+	return self
+}
+
+// Filter appends a FilterFunction to the end of the filter chain of this route.
+func (self *RouteBuilder) Filter(filter FilterFunction) *RouteBuilder {
+	self.filters = append(self.filters, filter)
+	return self
+}
+
+// ContentEncodingEnabled overrides (for this Route only) whether the response may be
+// gzip/deflate encoded based on the request's Accept-Encoding header.
+func (self *RouteBuilder) ContentEncodingEnabled(enabled bool) *RouteBuilder {
+	self.contentEncodingEnabled = &enabled
+	return self
+}
+
+// Param adds a Parameter to document the usage of this route.
+func (self *RouteBuilder) Param(parameter *Parameter) *RouteBuilder {
+	self.parameters = append(self.parameters, parameter)
+	return self
+}
+
+// Doc sets the short documentation string of this route, used by e.g. the swagger subsystem.
+func (self *RouteBuilder) Doc(documentation string) *RouteBuilder {
+	self.doc = documentation
+	return self
+}
+
+// Notes sets the additional (longer) documentation of this route.
+func (self *RouteBuilder) Notes(notes string) *RouteBuilder {
+	self.notes = notes
+	return self
+}
+
+// Operation sets the unique nickname of this route, used by e.g. the swagger subsystem
+// and by client code generators to name the generated function.
+func (self *RouteBuilder) Operation(name string) *RouteBuilder {
+	self.operation = name
+	return self
+}
+
+// Reads documents the expected request body by example value; its Go type is reflected
+// upon to produce the swagger definition.
+func (self *RouteBuilder) Reads(sample interface{}) *RouteBuilder {
+	self.readSample = sample
+	return self
+}
+
+// Writes documents the response body by example value; its Go type is reflected upon to
+// produce the swagger definition.
+func (self *RouteBuilder) Writes(sample interface{}) *RouteBuilder {
+	self.writeSample = sample
+	return self
+}
+
+// Returns documents a response this route may produce, in addition to its default Writes
+// sample, for e.g. error codes.
+func (self *RouteBuilder) Returns(code int, message string, model interface{}) *RouteBuilder {
+	if self.errorMap == nil {
+		self.errorMap = map[int]ResponseError{}
+	}
+	self.errorMap[code] = ResponseError{Code: code, Message: message, Model: model}
+	return self
+}
+
+// joinPaths concatenates a WebService root path and a Route's relative subPath, collapsing
+// the joining slash so a root of "/" does not produce a leading "//" (e.g. "/", "/items" ->
+// "/items"). An empty subPath leaves the root untouched ("/apidocs.json", "" -> "/apidocs.json").
+func joinPaths(root, subPath string) string {
+	if subPath == "" {
+		if root == "" {
+			return "/"
+		}
+		return root
+	}
+	if !strings.HasPrefix(subPath, "/") {
+		subPath = "/" + subPath
+	}
+	return strings.TrimRight(root, "/") + subPath
+}
+
+// copyDefaults fills in the Produces/Consumes if the builder has none set.
+func (self *RouteBuilder) copyDefaults(produces, consumes []string) {
+	if len(self.produces) == 0 {
+		self.produces = produces
+	}
+	if len(self.consumes) == 0 {
+		self.consumes = consumes
+	}
+}
+
+// Build creates a new Route using the specification details collected on this RouteBuilder.
+func (self *RouteBuilder) Build() Route {
+	fullPath := joinPaths(self.rootPath, self.currentPath)
+	route := Route{
+		Method:        self.httpMethod,
+		Produces:      self.produces,
+		Consumes:      self.consumes,
+		Path:          fullPath,
+		Function:      self.function,
+		Filters:       self.filters,
+		relativePath:  strings.TrimRight(fullPath, "/"),
+		ParameterDocs: self.parameters,
+		contentEncodingEnabled: self.contentEncodingEnabled,
+		Doc:           self.doc,
+		Notes:         self.notes,
+		Operation:     self.operation,
+		ReadSample:    self.readSample,
+		WriteSample:   self.writeSample,
+		ResponseErrors: self.errorMap,
+	}
+	pathExpr, err := NewPathExpression(route.relativePath)
+	if err != nil {
+		log.Fatalf("[restful] Invalid path:%s because:%v", route.relativePath, err)
+	}
+	route.pathExpr = pathExpr
+	route.postBuild()
+	return route
+}
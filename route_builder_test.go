@@ -0,0 +1,33 @@
+package restful
+
+import "testing"
+
+func TestRouteBuilderBuildNormalizesRootSlash(t *testing.T) {
+	ws := new(WebService)
+	ws.Path("/")
+	route := ws.GET("/items").To(dummyRouteFunction).Build()
+
+	if route.Path != "/items" {
+		t.Errorf("expected Path /items, got %s", route.Path)
+	}
+}
+
+func TestRouteBuilderBuildKeepsNonRootPath(t *testing.T) {
+	ws := new(WebService)
+	ws.Path("/items")
+	route := ws.GET("/{id}").To(dummyRouteFunction).Build()
+
+	if route.Path != "/items/{id}" {
+		t.Errorf("expected Path /items/{id}, got %s", route.Path)
+	}
+}
+
+func TestRouteBuilderBuildEmptySubPathKeepsRoot(t *testing.T) {
+	ws := new(WebService)
+	ws.Path("/apidocs.json")
+	route := ws.GET("").To(dummyRouteFunction).Build()
+
+	if route.Path != "/apidocs.json" {
+		t.Errorf("expected Path /apidocs.json, got %s", route.Path)
+	}
+}
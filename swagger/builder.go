@@ -0,0 +1,206 @@
+package swagger
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	restful "github.com/BlueFireworks/go-restful"
+)
+
+// BuildSwagger walks the Routes of the configured WebServices and produces a Swagger 2.0 document.
+func BuildSwagger(cfg Config) *Swagger {
+	spec := &Swagger{
+		SwaggerVersion: "2.0",
+		Info:           cfg.Info,
+		BasePath:       cfg.WebServicesUrl,
+		Paths:          map[string]PathItem{},
+		Definitions:    map[string]Schema{},
+	}
+	definitions := map[string]Schema{}
+	for _, ws := range cfg.WebServices {
+		for _, route := range ws.Routes() {
+			addRoute(spec, route, definitions)
+		}
+	}
+	spec.Definitions = definitions
+	return spec
+}
+
+func addRoute(spec *Swagger, route restful.Route, definitions map[string]Schema) {
+	operation := &Operation{
+		OperationId: route.Operation,
+		Summary:     route.Doc,
+		Description: route.Notes,
+		Consumes:    route.Consumes,
+		Produces:    route.Produces,
+		Responses:   map[string]Response{},
+	}
+	for _, p := range route.ParameterDocs {
+		operation.Parameters = append(operation.Parameters, toSwaggerParameter(p, definitions))
+	}
+	if route.WriteSample != nil {
+		schema := reflectSchema(reflect.TypeOf(route.WriteSample), definitions)
+		operation.Responses["200"] = Response{Description: "OK", Schema: &schema}
+	} else {
+		operation.Responses["200"] = Response{Description: "OK"}
+	}
+	for code, responseErr := range route.ResponseErrors {
+		resp := Response{Description: responseErr.Message}
+		if responseErr.Model != nil {
+			schema := reflectSchema(reflect.TypeOf(responseErr.Model), definitions)
+			resp.Schema = &schema
+		}
+		operation.Responses[strconv.Itoa(code)] = resp
+	}
+
+	swaggerPath := toSwaggerPath(route.Path)
+	item := spec.Paths[swaggerPath]
+	switch route.Method {
+	case "GET":
+		item.Get = operation
+	case "POST":
+		item.Post = operation
+	case "PUT":
+		item.Put = operation
+	case "DELETE":
+		item.Delete = operation
+	case "PATCH":
+		item.Patch = operation
+	}
+	spec.Paths[swaggerPath] = item
+}
+
+// toSwaggerPath rewrites a restful curly path template ({id}, {id:[0-9]+}) into the
+// swagger path template syntax ({id}).
+func toSwaggerPath(path string) string {
+	var buffer strings.Builder
+	token := ""
+	inToken := false
+	for _, r := range path {
+		switch r {
+		case '{':
+			inToken = true
+			token = ""
+		case '}':
+			inToken = false
+			name := token
+			if colon := strings.Index(token, ":"); colon != -1 {
+				name = token[:colon]
+			}
+			buffer.WriteString("{")
+			buffer.WriteString(name)
+			buffer.WriteString("}")
+		default:
+			if inToken {
+				token += string(r)
+			} else {
+				buffer.WriteRune(r)
+			}
+		}
+	}
+	return buffer.String()
+}
+
+func toSwaggerParameter(p *restful.Parameter, definitions map[string]Schema) Parameter {
+	sp := Parameter{
+		Name:        p.ParameterData.Name,
+		Description: p.ParameterData.Description,
+		Required:    p.ParameterData.Required,
+		Type:        swaggerType(p.ParameterData.DataType),
+	}
+	switch p.KindOf() {
+	case restful.PATH_PARAMETER:
+		sp.In = "path"
+	case restful.QUERY_PARAMETER:
+		sp.In = "query"
+	case restful.HEADER_PARAMETER:
+		sp.In = "header"
+	case restful.BODY_PARAMETER:
+		sp.In = "body"
+	}
+	return sp
+}
+
+func swaggerType(dataType string) string {
+	if dataType == "" {
+		return "string"
+	}
+	return dataType
+}
+
+// reflectSchema builds (and registers, for structs) a Schema for a Go type, following
+// json struct tags, embedded structs, and slice/map/pointer indirection.
+func reflectSchema(t reflect.Type, definitions map[string]Schema) Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		item := reflectSchema(t.Elem(), definitions)
+		return Schema{Type: "array", Items: &item}
+	case reflect.Map:
+		item := reflectSchema(t.Elem(), definitions)
+		return Schema{Type: "object", Items: &item}
+	case reflect.Struct:
+		name := t.Name()
+		if _, done := definitions[name]; !done {
+			definitions[name] = Schema{Type: "object"} // placeholder to stop recursion on self-referencing types
+			definitions[name] = reflectStruct(t, definitions)
+		}
+		return Schema{Ref: "#/definitions/" + name}
+	case reflect.String:
+		return Schema{Type: "string"}
+	case reflect.Bool:
+		return Schema{Type: "boolean"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{Type: "number"}
+	default:
+		if isIntKind(t.Kind()) {
+			return Schema{Type: "integer"}
+		}
+		return Schema{Type: "string"}
+	}
+}
+
+func isIntKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+func reflectStruct(t reflect.Type, definitions map[string]Schema) Schema {
+	properties := map[string]*Schema{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" { // unexported
+			continue
+		}
+		jsonTag := field.Tag.Get("json")
+		if jsonTag == "-" {
+			continue
+		}
+		name := field.Name
+		tagParts := strings.Split(jsonTag, ",")
+		if len(tagParts) > 0 && tagParts[0] != "" {
+			name = tagParts[0]
+		}
+		if field.Anonymous {
+			embedded := reflectSchema(field.Type, definitions)
+			if embedded.Ref != "" {
+				if nested, ok := definitions[strings.TrimPrefix(embedded.Ref, "#/definitions/")]; ok {
+					for k, v := range nested.Properties {
+						properties[k] = v
+					}
+				}
+				continue
+			}
+		}
+		propSchema := reflectSchema(field.Type, definitions)
+		properties[name] = &propSchema
+	}
+	return Schema{Type: "object", Properties: properties}
+}
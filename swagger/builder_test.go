@@ -0,0 +1,66 @@
+package swagger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	restful "github.com/BlueFireworks/go-restful"
+)
+
+type testItem struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestBuildSwaggerProducesPathsAndDefinitions(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Path("/items")
+	ws.Produces("application/json")
+	ws.Route(ws.GET("/{id}").
+		To(func(*restful.Request, *restful.Response) {}).
+		Doc("get an item").
+		Writes(testItem{}))
+
+	spec := BuildSwagger(Config{WebServicesUrl: "http://localhost", WebServices: []*restful.WebService{ws}})
+
+	path, ok := spec.Paths["/items/{id}"]
+	if !ok {
+		t.Fatalf("expected a path entry for /items/{id}, got %v", spec.Paths)
+	}
+	if path.Get == nil {
+		t.Fatalf("expected a GET operation on /items/{id}")
+	}
+	if path.Get.Summary != "get an item" {
+		t.Errorf("expected Summary %q, got %q", "get an item", path.Get.Summary)
+	}
+	def, ok := spec.Definitions["testItem"]
+	if !ok {
+		t.Fatalf("expected a testItem definition, got %v", spec.Definitions)
+	}
+	if _, ok := def.Properties["id"]; !ok {
+		t.Errorf("expected testItem definition to have an id property, got %v", def.Properties)
+	}
+}
+
+func TestRegisterSwaggerServiceFallsBackToContainerWebServices(t *testing.T) {
+	container := restful.NewContainer()
+	ws := new(restful.WebService)
+	ws.Path("/items")
+	ws.Route(ws.GET("").To(func(*restful.Request, *restful.Response) {}))
+	container.Add(ws)
+
+	RegisterSwaggerService(Config{ApiPath: "/apidocs.json"}, container)
+
+	recorder := httptest.NewRecorder()
+	httpRequest := httptest.NewRequest(http.MethodGet, "/apidocs.json", nil)
+	container.ServeHTTP(recorder, httpRequest)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected 200 from the swagger endpoint, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	if !strings.Contains(recorder.Body.String(), "/items") {
+		t.Errorf("expected the generated spec to document /items, got %s", recorder.Body.String())
+	}
+}
@@ -0,0 +1,24 @@
+// Package swagger generates a Swagger 2.0 specification document from a set of
+// registered restful.WebService instances and serves it (and optionally the
+// swagger-ui static assets) through a Container.
+package swagger
+
+import (
+	restful "github.com/BlueFireworks/go-restful"
+)
+
+// Config controls how the Swagger specification is generated and served.
+type Config struct {
+	// WebServicesUrl is the (external) root URL from which WebServices are served, e.g. http://localhost:8080
+	WebServicesUrl string
+	// ApiPath is the path on which the generated Swagger document itself is served, e.g. /apidocs.json
+	ApiPath string
+	// SwaggerPath is the path on which the swagger-ui is served, e.g. /apidocs/
+	SwaggerPath string
+	// SwaggerFilePath is the location on disk of the swagger-ui static assets. Optional.
+	SwaggerFilePath string
+	// WebServices is the list of WebServices to document. If empty, all WebServices of the Container are used.
+	WebServices []*restful.WebService
+	// Info is included verbatim as the "info" object of the generated document.
+	Info Info
+}
@@ -0,0 +1,31 @@
+package swagger
+
+import (
+	"encoding/json"
+	"net/http"
+
+	restful "github.com/BlueFireworks/go-restful"
+)
+
+// RegisterSwaggerService adds a WebService to the Container that serves the generated
+// Swagger specification at cfg.ApiPath, and (if cfg.SwaggerFilePath is set) the
+// swagger-ui static assets at cfg.SwaggerPath.
+func RegisterSwaggerService(cfg Config, container *restful.Container) {
+	if len(cfg.WebServices) == 0 {
+		cfg.WebServices = container.RegisteredWebServices()
+	}
+	ws := new(restful.WebService)
+	ws.Path(cfg.ApiPath)
+	ws.Produces("application/json")
+	ws.Route(ws.GET("").To(func(req *restful.Request, resp *restful.Response) {
+		spec := BuildSwagger(cfg)
+		resp.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(resp).Encode(spec)
+	}))
+	container.Add(ws)
+
+	if cfg.SwaggerFilePath != "" && cfg.SwaggerPath != "" {
+		fileServer := http.FileServer(http.Dir(cfg.SwaggerFilePath))
+		container.ServeMux.Handle(cfg.SwaggerPath, http.StripPrefix(cfg.SwaggerPath, fileServer))
+	}
+}
@@ -0,0 +1,60 @@
+package swagger
+
+// The types below are a (partial) representation of the Swagger 2.0
+// specification, sufficient to describe the Routes of a restful.WebService.
+// See http://swagger.io/specification/
+
+type Swagger struct {
+	SwaggerVersion string                 `json:"swagger"`
+	Info           Info                   `json:"info"`
+	Host           string                 `json:"host,omitempty"`
+	BasePath       string                 `json:"basePath,omitempty"`
+	Paths          map[string]PathItem    `json:"paths"`
+	Definitions    map[string]Schema      `json:"definitions,omitempty"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version,omitempty"`
+}
+
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty"`
+	Post   *Operation `json:"post,omitempty"`
+	Put    *Operation `json:"put,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+	Patch  *Operation `json:"patch,omitempty"`
+}
+
+type Operation struct {
+	OperationId string              `json:"operationId,omitempty"`
+	Summary     string              `json:"summary,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Consumes    []string            `json:"consumes,omitempty"`
+	Produces    []string            `json:"produces,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"` // "path", "query", "header", "body"
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Type        string  `json:"type,omitempty"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+type Schema struct {
+	Ref        string             `json:"$ref,omitempty"`
+	Type       string             `json:"type,omitempty"`
+	Format     string             `json:"format,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+}
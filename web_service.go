@@ -13,6 +13,8 @@ type WebService struct {
 	consumes       []string
 	pathParameters []*Parameter
 	filters        []FilterFunction
+	contentEncodingEnabled *bool // nil means: inherit the Container-wide default
+	documentation  string
 }
 
 // Path specifies the root URL template path of the WebService.
@@ -65,7 +67,19 @@ func (self *WebService) BodyParameter(name, description string) *Parameter {
 // Route creates a new Route using the RouteBuilder and add to the ordered list of Routes.
 func (self *WebService) Route(builder *RouteBuilder) *WebService {
 	builder.copyDefaults(self.produces, self.consumes)
-	self.routes = append(self.routes, builder.Build())
+	route := builder.Build()
+	if route.contentEncodingEnabled == nil {
+		route.contentEncodingEnabled = self.contentEncodingEnabled
+	}
+	self.routes = append(self.routes, route)
+	return self
+}
+
+// ContentEncodingEnabled sets the default for whether Routes of this WebService may have
+// their response body gzip/deflate encoded based on the request's Accept-Encoding header.
+// A Route can override this default using RouteBuilder.ContentEncodingEnabled.
+func (self *WebService) ContentEncodingEnabled(enabled bool) *WebService {
+	self.contentEncodingEnabled = &enabled
 	return self
 }
 
@@ -91,6 +105,17 @@ func (self WebService) Routes() []Route {
 	return self.routes
 }
 
+// Doc sets the documentation of this WebService, used by e.g. the swagger subsystem.
+func (self *WebService) Doc(plainText string) *WebService {
+	self.documentation = plainText
+	return self
+}
+
+// Documentation returns the documentation of this WebService as set by Doc.
+func (self WebService) Documentation() string {
+	return self.documentation
+}
+
 // RootPath returns the RootPath associated with this WebService. Default "/"
 func (self WebService) RootPath() string {
 	return self.rootPath